@@ -0,0 +1,66 @@
+package main
+
+import (
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// checkpointDir is where this plugin stores the checkpoints it creates, kept separate from the
+// daemon's default location so "docker checkpoint ls" only ever lists checkpoints this plugin
+// knows how to restore.
+func checkpointDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "docker-ps-bitbar", "checkpoints")
+}
+
+// checkpointCreate checkpoints containerID, naming the checkpoint after the current time so
+// that checkpoints of the same container sort chronologically in the menu.
+func checkpointCreate(bin, containerID string) error {
+	dir := checkpointDir()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	name := time.Now().Format("2006-01-02T15-04-05")
+	cmd := exec.Command(bin, "checkpoint", "create", "--checkpoint-dir", dir, containerID, name)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// checkpointRestore starts containerID from a previously created checkpoint.
+func checkpointRestore(bin, containerID, name string) error {
+	cmd := exec.Command(bin, "start", "--checkpoint-dir", checkpointDir(), "--checkpoint", name, containerID)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// checkpointLs returns the names of existing checkpoints for containerID, oldest first.
+func checkpointLs(bin, containerID string) ([]string, error) {
+	cmd := exec.Command(bin, "checkpoint", "ls", "--checkpoint-dir", checkpointDir(), containerID)
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) < 2 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		if fields := strings.Fields(line); len(fields) != 0 {
+			names = append(names, fields[0])
+		}
+	}
+	return names, nil
+}
@@ -9,16 +9,12 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
-	"os/exec"
 	"sort"
 	"strings"
 	"time"
@@ -26,8 +22,6 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-const dockerBin = "/usr/local/bin/docker"
-
 type containerType int
 
 const (
@@ -64,11 +58,20 @@ type container struct {
 	project project
 }
 
-// fill sets project field, and may also change other fields.
-func (c *container) fill() {
-	c.project.typ = Single
+// joinLabels renders a label map in the same "k=v,k2=v2" shape the CLI's {{json .}} format
+// produces, so it can be parsed by projectOf regardless of which engine produced it.
+func joinLabels(labels map[string]string) string {
+	kv := make([]string, 0, len(labels))
+	for k, v := range labels {
+		kv = append(kv, k+"="+v)
+	}
+	return strings.Join(kv, ",")
+}
 
-	for _, part := range strings.Split(c.Labels, ",") {
+// projectOf parses a comma-separated "k=v,k2=v2" label string (the shape of container.Labels,
+// network.Labels, and volume.Labels) and returns the project it identifies, if any.
+func projectOf(labels string) project {
+	for _, part := range strings.Split(labels, ",") {
 		pair := strings.Split(part, "=")
 		if len(pair) != 2 {
 			continue
@@ -77,27 +80,27 @@ func (c *container) fill() {
 		k, v := pair[0], pair[1]
 		switch k {
 		case "com.github.AlekSi.docker-ps.group":
-			c.project.typ = Group
-			c.project.name = v
+			return project{typ: Group, name: v}
 		case "com.docker.compose.project":
-			c.project.typ = Compose
-			c.project.name = v
+			return project{typ: Compose, name: v}
 		case "io.kubernetes.pod.namespace":
-			c.project.typ = Kubernetes
-			c.project.name = v
-			c.Image = "" // remove very long image name with sha256 hash tag
+			return project{typ: Kubernetes, name: v}
 		case "name.minikube.sigs.k8s.io":
-			c.project.typ = Minikube
-			c.project.name = v
-			c.Image = "" // remove very long image name with sha256 hash tag
+			return project{typ: Minikube, name: v}
 		case "talos.cluster.name":
-			c.project.typ = Talos
-			c.project.name = v
+			return project{typ: Talos, name: v}
 		}
+	}
 
-		if c.project.name != "" {
-			return
-		}
+	return project{typ: Single}
+}
+
+// fill sets project field, and may also change other fields.
+func (c *container) fill() {
+	c.project = projectOf(c.Labels)
+
+	if c.project.typ == Kubernetes || c.project.typ == Minikube {
+		c.Image = "" // remove very long image name with sha256 hash tag
 	}
 }
 
@@ -110,87 +113,24 @@ func (c *container) running() bool {
 	return c.State == "running" || strings.HasPrefix(c.Status, "Up ")
 }
 
-// containerLs returns all containers sorted by "project" (Docker Compose project, Kubernetes namespace,
-// Minikube profile name, Talos cluster) and name.
-func containerLs() ([]container, error) {
-	cmd := exec.Command(dockerBin, "container", "ls", "--all", "--no-trunc", "--format={{json .}}")
-	cmd.Stderr = os.Stderr
-	b, err := cmd.Output()
-	if err != nil {
-		return nil, err
-	}
-
-	var containers []container
-	d := json.NewDecoder(bytes.NewReader(b))
-	for {
-		var c container
-		if err = d.Decode(&c); err != nil {
-			if err == io.EOF {
-				break
-			}
-			return nil, err
-		}
-		c.fill()
-		containers = append(containers, c)
-	}
-
-	sort.Slice(containers, func(i int, j int) bool {
-		if containers[i].project.typ != containers[j].project.typ {
-			return containers[i].project.typ < containers[j].project.typ
-		}
-		if containers[i].project.name != containers[j].project.name {
-			return containers[i].project.name < containers[j].project.name
-		}
-		return containers[i].Names < containers[j].Names
-	})
-
-	return containers, nil
+func (c *container) paused() bool {
+	return c.State == "paused"
 }
 
-// network contains parsed `docker network ls` output for a single network.
+// network contains parsed `docker network ls` / `podman network ls` output for a single network.
 type network struct {
 	CreatedAt string `json:"CreatedAt"`
 	Driver    string `json:"Driver"`
 	ID        string `json:"ID"`
+	Labels    string `json:"Labels"`
 	Name      string `json:"Name"`
 	Scope     string `json:"Scope"`
 }
 
-// networkLs returns all networks.
-func networkLs() ([]network, error) {
-	cmd := exec.Command(dockerBin, "network", "ls", "--no-trunc", "--format={{json .}}")
-	cmd.Stderr = os.Stderr
-	b, err := cmd.Output()
-	if err != nil {
-		return nil, err
-	}
-
-	var networks []network
-	d := json.NewDecoder(bytes.NewReader(b))
-	for {
-		var n network
-		if err = d.Decode(&n); err != nil {
-			if err == io.EOF {
-				break
-			}
-			return nil, err
-		}
-		networks = append(networks, n)
-	}
-
-	sort.Slice(networks, func(i int, j int) bool {
-		if networks[i].Driver != networks[j].Driver {
-			return networks[i].Driver < networks[j].Driver
-		}
-		return networks[i].Name < networks[j].Name
-	})
-
-	return networks, nil
-}
-
-// volume contains parsed `docker volume ls` output for a single volume.
+// volume contains parsed `docker volume ls` / `podman volume ls` output for a single volume.
 type volume struct {
 	Driver string `json:"Driver"`
+	Labels string `json:"Labels"`
 	Name   string `json:"Name"`
 }
 
@@ -206,40 +146,8 @@ func (v *volume) anonymous() bool {
 	return err == nil
 }
 
-// volumeLs returns all volumes.
-func volumeLs() ([]volume, error) {
-	cmd := exec.Command(dockerBin, "volume", "ls", "--format={{json .}}")
-	cmd.Stderr = os.Stderr
-	b, err := cmd.Output()
-	if err != nil {
-		return nil, err
-	}
-
-	var volumes []volume
-	d := json.NewDecoder(bytes.NewReader(b))
-	for {
-		var v volume
-		if err = d.Decode(&v); err != nil {
-			if err == io.EOF {
-				break
-			}
-			return nil, err
-		}
-		volumes = append(volumes, v)
-	}
-
-	sort.Slice(volumes, func(i int, j int) bool {
-		if volumes[i].Driver != volumes[j].Driver {
-			return volumes[i].Driver < volumes[j].Driver
-		}
-		return volumes[i].Name < volumes[j].Name
-	})
-
-	return volumes, nil
-}
-
-func containerCmd(command, projectName string) {
-	containers, err := containerLs()
+func containerCmd(ctx context.Context, e engine, command, projectName string) {
+	containers, err := e.containerLs(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -258,6 +166,10 @@ func containerCmd(command, projectName string) {
 			add = true
 		case "stop", "kill":
 			add = c.running()
+		case "pause":
+			add = c.running() && !c.paused()
+		case "unpause":
+			add = c.paused()
 		default:
 			log.Fatalf("Unexpected command %s.", command)
 		}
@@ -266,32 +178,82 @@ func containerCmd(command, projectName string) {
 			ids = append(ids, c.ID)
 		}
 	}
-	if len(ids) == 0 {
-		return
+	if err = e.containerCmd(command, ids); err != nil {
+		log.Fatal(err)
 	}
+}
 
-	args := []string{command}
-	if command == "rm" {
-		args = append(args, "--force", "--volumes")
+// pruneProjectCmd removes only the containers, networks, and volumes whose project label (any
+// label projectOf recognises: group, Compose project, Kubernetes namespace, Minikube profile,
+// or Talos cluster) has the value projectName; unlike the blanket pruneCmd this is safe to run
+// on a workstation shared with other projects.
+func pruneProjectCmd(ctx context.Context, e engine, projectName string) {
+	containers, err := e.containerLs(ctx)
+	if err != nil {
+		log.Fatal(err)
 	}
-	args = append(args, ids...)
-	cmd := exec.Command(dockerBin, args...)
-	log.Print(strings.Join(cmd.Args, " "))
-	cmd.Stderr = os.Stderr
-	if err = cmd.Run(); err != nil {
+	var ids []string
+	for _, c := range containers {
+		if c.project.name == projectName {
+			ids = append(ids, c.ID)
+		}
+	}
+	if err = e.containerCmd("rm", ids); err != nil {
+		log.Print(err)
+	}
+
+	networks, err := e.networkLs(ctx)
+	if err != nil {
 		log.Fatal(err)
 	}
+	var networkNames []string
+	for _, n := range networks {
+		if projectOf(n.Labels).name == projectName {
+			networkNames = append(networkNames, n.Name)
+		}
+	}
+	if err = e.networkRm(networkNames); err != nil {
+		log.Print(err)
+	}
+
+	volumes, err := e.volumeLs(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var volumeNames []string
+	for _, v := range volumes {
+		if projectOf(v.Labels).name == projectName {
+			volumeNames = append(volumeNames, v.Name)
+		}
+	}
+	if err = e.volumeRm(volumeNames); err != nil {
+		log.Print(err)
+	}
+}
+
+// talosClusters returns the distinct Talos cluster names (the talos.cluster.name label) found
+// across all engines' containers, sorted.
+func talosClusters(containers [][]container) []string {
+	seen := map[string]bool{}
+	for _, cs := range containers {
+		for _, c := range cs {
+			if c.project.typ == Talos {
+				seen[c.project.name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func pruneCmd() {
-	for _, cmdline := range []string{
-		"buildx prune --force",
-		"system prune --force --volumes",
-	} {
-		cmd := exec.Command(dockerBin, strings.Split(cmdline, " ")...)
-		log.Print(strings.Join(cmd.Args, " "))
-		cmd.Stderr = os.Stderr
-		if err := cmd.Run(); err != nil {
+	for _, e := range engines() {
+		if err := e.pruneCmd(); err != nil {
 			log.Print(err)
 		}
 	}
@@ -300,44 +262,106 @@ func pruneCmd() {
 func defaultCmd(ctx context.Context) {
 	bin, _ := os.Executable()
 
-	var containers []container
-	var networks []network
-	var volumes []volume
+	engs := engines()
+	containers := make([][]container, len(engs))
+	networks := make([][]network, len(engs))
+	volumes := make([][]volume, len(engs))
 
-	g, ctx := errgroup.WithContext(ctx)
-	_ = ctx // TODO
-	g.Go(func() error {
-		var err error
-		containers, err = containerLs()
-		return err
-	})
-	g.Go(func() error {
-		var err error
-		networks, err = networkLs()
-		return err
-	})
+	var minikubeLines []string
+	var minikubeRunning bool
+
+	g, gctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
-		var err error
-		volumes, err = volumeLs()
-		return err
+		minikubeLines, minikubeRunning = minikubeStatus()
+		return nil
 	})
+	for i, e := range engs {
+		i, e := i, e
+		g.Go(func() error {
+			var err error
+			containers[i], err = e.containerLs(gctx)
+			return err
+		})
+		g.Go(func() error {
+			var err error
+			networks[i], err = e.networkLs(gctx)
+			return err
+		})
+		g.Go(func() error {
+			var err error
+			volumes[i], err = e.volumeLs(gctx)
+			return err
+		})
+	}
 	if err := g.Wait(); err != nil {
 		log.Fatal(err)
 	}
 
-	if len(containers) == 0 {
-		fmt.Println("🐳")
-	} else {
-		var total, running int
-		for _, c := range containers {
-			total++
+	var totalContainers, totalRunning int
+	for _, cs := range containers {
+		for _, c := range cs {
+			totalContainers++
 			if c.running() {
-				running++
+				totalRunning++
 			}
 		}
-		fmt.Printf("🐳%d/%d\n", running, total)
 	}
+	if totalContainers == 0 {
+		fmt.Println("🐳")
+	} else {
+		fmt.Printf("🐳%d/%d\n", totalRunning, totalContainers)
+	}
+
+	if len(minikubeLines) != 0 {
+		fmt.Println("---")
+		for _, l := range minikubeLines {
+			fmt.Println(l)
+		}
+		if minikubeRunning {
+			fmt.Printf("-- ⏹ Stop | bash=%q param1=-minikube=stop terminal=false refresh=true\n", bin)
+		}
+		fmt.Printf("-- 🗑 Delete | bash=%q param1=-minikube=delete terminal=false refresh=true\n", bin)
+	}
+
+	clusters := talosClusters(containers)
+	talosLines := make([][]string, len(clusters))
+	tg, tgctx := errgroup.WithContext(ctx)
+	for i, cluster := range clusters {
+		i, cluster := i, cluster
+		tg.Go(func() error {
+			talosLines[i], _ = talosStatus(tgctx, cluster)
+			return nil
+		})
+	}
+	_ = tg.Wait()
+
+	for i, cluster := range clusters {
+		for _, l := range talosLines[i] {
+			fmt.Println("---")
+			fmt.Println(l)
+			fmt.Printf("-- ⚓️ Bootstrap | bash=%q param1=-talos=%s param2=bootstrap terminal=false refresh=true\n", bin, cluster)
+			fmt.Printf("-- ♻️ Reset | bash=%q param1=-talos=%s param2=reset terminal=false refresh=true\n", bin, cluster)
+		}
+	}
+
+	for i, e := range engs {
+		renderEngine(ctx, bin, e, containers[i], networks[i], volumes[i])
+	}
+
+	if bin != "" {
+		fmt.Println("---")
+		fmt.Printf("⭕️ Stop all containers | bash=%q param1=stop terminal=false refresh=true\n", bin)
+		fmt.Printf("🛑 Remove stopped containers | bash=%q param1=rm terminal=false refresh=true\n", bin)
+		fmt.Printf("⚠️ Prune EVERYTHING | bash=%q param1=-prune param2=-confirm terminal=false refresh=true\n", bin)
+		fmt.Printf("📛 Stop all, remove stopped, and prune EVERYTHING | bash=%q param1=-prune param2=-confirm param3=kill terminal=false refresh=true\n", bin)
+	}
+}
+
+// renderEngine prints one engine's section of the menu: its containers grouped by project,
+// then its networks and volumes.
+func renderEngine(ctx context.Context, bin string, e engine, containers []container, networks []network, volumes []volume) {
 	fmt.Println("---")
+	fmt.Printf("%s %s\n", e.icon(), e.name())
 
 	var lastProjectName string
 	for _, c := range containers {
@@ -347,15 +371,18 @@ func defaultCmd(ctx context.Context) {
 			fmt.Println("---")
 			switch c.project.typ {
 			case Group:
-				fmt.Printf("🐳 %s\n", lastProjectName)
+				fmt.Printf("%s %s\n", e.icon(), lastProjectName)
+
+				fmt.Printf("-- ⛔️ Prune this project | bash=%q param1=-engine=%s param2=-project=%s param3=prune terminal=false refresh=true\n", bin, e.name(), lastProjectName)
 
 			case Compose:
 				fmt.Printf("🐙 %s\n", lastProjectName)
 
-				fmt.Printf("-- ▶️ Start all | bash=%q param1=-project=%s param2=start terminal=false refresh=true\n", bin, lastProjectName)
-				fmt.Printf("-- 🔄 Restart all | bash=%q param1=-project=%s param2=restart terminal=false refresh=true\n", bin, lastProjectName)
-				fmt.Printf("-- ⏹ Stop all | bash=%q param1=-project=%s param2=stop terminal=false refresh=true\n", bin, lastProjectName)
-				fmt.Printf("-- ⏬ Stop and remove all | bash=%q param1=-project=%s param2=kill param3=rm terminal=false refresh=true\n", bin, lastProjectName)
+				fmt.Printf("-- ▶️ Start all | bash=%q param1=-engine=%s param2=-project=%s param3=start terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- 🔄 Restart all | bash=%q param1=-engine=%s param2=-project=%s param3=restart terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- ⏹ Stop all | bash=%q param1=-engine=%s param2=-project=%s param3=stop terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- ⏬ Stop and remove all | bash=%q param1=-engine=%s param2=-project=%s param3=kill param4=rm terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- ⛔️ Prune this project | bash=%q param1=-engine=%s param2=-project=%s param3=prune terminal=false refresh=true\n", bin, e.name(), lastProjectName)
 
 			case Kubernetes:
 				fmt.Printf("☸️ %s\n", lastProjectName)
@@ -366,17 +393,18 @@ func defaultCmd(ctx context.Context) {
 			case Talos:
 				fmt.Printf("🔺 %s\n", lastProjectName)
 
-				fmt.Printf("-- ▶️ Start all | bash=%q param1=-project=%s param2=start terminal=false refresh=true\n", bin, lastProjectName)
-				fmt.Printf("-- ⏹ Stop all | bash=%q param1=-project=%s param2=stop terminal=false refresh=true\n", bin, lastProjectName)
-				fmt.Printf("-- 🔄 Restart all | bash=%q param1=-project=%s param2=restart terminal=false refresh=true\n", bin, lastProjectName)
-				fmt.Printf("-- ⏬ Stop and remove all | bash=%q param1=-project=%s param2=kill param3=rm terminal=false refresh=true\n", bin, lastProjectName)
+				fmt.Printf("-- ▶️ Start all | bash=%q param1=-engine=%s param2=-project=%s param3=start terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- ⏹ Stop all | bash=%q param1=-engine=%s param2=-project=%s param3=stop terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- 🔄 Restart all | bash=%q param1=-engine=%s param2=-project=%s param3=restart terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- ⏬ Stop and remove all | bash=%q param1=-engine=%s param2=-project=%s param3=kill param4=rm terminal=false refresh=true\n", bin, e.name(), lastProjectName)
+				fmt.Printf("-- ⛔️ Prune this project | bash=%q param1=-engine=%s param2=-project=%s param3=prune terminal=false refresh=true\n", bin, e.name(), lastProjectName)
 
 			default:
 				log.Fatalf("Unexpected project type %v.", c.project.typ)
 			}
 		}
 
-		icon := "🐳"
+		icon := e.icon()
 		if strings.HasPrefix(c.Image, "moby/buildkit:") {
 			icon = "⚙️"
 		}
@@ -386,9 +414,37 @@ func defaultCmd(ctx context.Context) {
 			fmt.Printf("(%s) ", c.Image)
 		}
 		if c.running() {
-			fmt.Printf("| color=green bash=%q param1=stop param2=%s terminal=false refresh=true\n", dockerBin, c.ID)
+			fmt.Printf("| color=green bash=%q param1=stop param2=%s terminal=false refresh=true\n", e.binPath(), c.ID)
 		} else {
-			fmt.Printf("| color=red bash=%q param1=start param2=%s terminal=false refresh=true\n", dockerBin, c.ID)
+			fmt.Printf("| color=red bash=%q param1=start param2=%s terminal=false refresh=true\n", e.binPath(), c.ID)
+		}
+
+		if c.paused() {
+			fmt.Printf("-- ▶️ Unpause | bash=%q param1=unpause param2=%s terminal=false refresh=true\n", e.binPath(), c.ID)
+		} else if c.running() {
+			fmt.Printf("-- ⏸ Pause | bash=%q param1=pause param2=%s terminal=false refresh=true\n", e.binPath(), c.ID)
+		}
+
+		// Checkpoint/restore is a Docker-specific feature (requires the experimental
+		// "checkpoint" CLI support); Podman's equivalent doesn't share the same named,
+		// listable checkpoint model, so it's not offered there. "docker checkpoint create"
+		// only works on a running container, and "docker start --checkpoint" only on a
+		// stopped one, so each button (and the checkpoint ls lookup the latter needs) is
+		// gated accordingly.
+		if e.name() == "docker" {
+			if c.running() {
+				fmt.Printf("-- 💾 Checkpoint | bash=%q param1=-checkpoint=%s param2=checkpoint terminal=false refresh=true\n", bin, c.ID)
+			} else if names, err := checkpointLs(e.binPath(), c.ID); err == nil {
+				for _, name := range names {
+					fmt.Printf("-- ⏮ Restore %s | bash=%q param1=-checkpoint=%s param2=-checkpoint-name=%s param3=restore terminal=false refresh=true\n", name, bin, c.ID, name)
+				}
+			}
+		}
+
+		if sp, ok := e.(statsProvider); ok && c.running() {
+			if stats, err := sp.containerStats(ctx, c.ID); err == nil {
+				fmt.Printf("-- %s\n", stats)
+			}
 		}
 	}
 
@@ -416,36 +472,88 @@ func defaultCmd(ctx context.Context) {
 			fmt.Printf("%d anonymous\n", anonymous)
 		}
 	}
-
-	if bin != "" {
-		fmt.Println("---")
-		fmt.Printf("⭕️ Stop all containers | bash=%q param1=stop terminal=false refresh=true\n", bin)
-		fmt.Printf("🛑 Remove stopped containers | bash=%q param1=rm terminal=false refresh=true\n", bin)
-		fmt.Printf("⛔️ Prune orphan data | bash=%q param1=-prune terminal=false refresh=true\n", bin)
-		fmt.Printf("📛 Stop, remove and and prune everything | bash=%q param1=-prune param2=kill terminal=false refresh=true\n", bin)
-	}
 }
 
 func main() {
 	projectF := flag.String("project", "", `"project" (Docker Compose project, Kubernetes namespace, Minikube profile name, Talos cluster)`)
-	pruneF := flag.Bool("prune", false, `prune stopped containers, networks, volumes, and caches`)
+	pruneF := flag.Bool("prune", false, `prune all dangling images, networks, volumes, and caches on the host, not just this project's`)
+	confirmF := flag.Bool("confirm", false, `required alongside -prune, to avoid pruning the whole host by accident`)
+	minikubeF := flag.String("minikube", "", `minikube action ("stop" or "delete")`)
+	talosF := flag.String("talos", "", `Talos cluster name, paired with a "bootstrap" or "reset" command`)
+	checkpointF := flag.String("checkpoint", "", `container ID, paired with a "checkpoint" or "restore" command`)
+	checkpointNameF := flag.String("checkpoint-name", "", `checkpoint name, required by the "restore" command`)
 	flag.Usage = func() {
 		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [command]\n\n", os.Args[0])
-		fmt.Fprintf(flag.CommandLine.Output(), "Commands: start, stop, restart, rm, kill.\n\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "Commands: start, stop, restart, rm, kill, pause, unpause, prune (requires -project).\n\n")
 		fmt.Fprintf(flag.CommandLine.Output(), "Flags:\n")
 		flag.PrintDefaults()
 	}
 	flag.Parse()
 
-	if flag.NArg() == 0 {
+	switch {
+	case *minikubeF != "":
+		switch *minikubeF {
+		case "stop":
+			minikubeStop()
+		case "delete":
+			minikubeDelete()
+		default:
+			log.Fatalf("Unexpected -minikube value %s.", *minikubeF)
+		}
+
+	case *talosF != "":
+		for _, c := range flag.Args() {
+			switch c {
+			case "bootstrap":
+				talosBootstrap(*talosF)
+			case "reset":
+				talosReset(*talosF)
+			default:
+				log.Fatalf("Unexpected command %s.", c)
+			}
+		}
+
+	case *checkpointF != "":
+		for _, c := range flag.Args() {
+			switch c {
+			case "checkpoint":
+				if err := checkpointCreate(selectedEngine().binPath(), *checkpointF); err != nil {
+					log.Fatal(err)
+				}
+			case "restore":
+				if *checkpointNameF == "" {
+					log.Fatal("-checkpoint-name is required for the restore command.")
+				}
+				if err := checkpointRestore(selectedEngine().binPath(), *checkpointF, *checkpointNameF); err != nil {
+					log.Fatal(err)
+				}
+			default:
+				log.Fatalf("Unexpected command %s.", c)
+			}
+		}
+
+	case flag.NArg() == 0:
 		defaultCmd(context.TODO())
-	} else {
+
+	default:
+		ctx := context.TODO()
+		e := selectedEngine()
 		for _, c := range flag.Args() {
-			containerCmd(c, *projectF)
+			if c == "prune" {
+				if *projectF == "" {
+					log.Fatal("-project is required for the prune command.")
+				}
+				pruneProjectCmd(ctx, e, *projectF)
+				continue
+			}
+			containerCmd(ctx, e, c, *projectF)
 		}
 	}
 
 	if *pruneF {
+		if !*confirmF {
+			log.Fatal("Refusing to -prune the whole host without -confirm.")
+		}
 		pruneCmd()
 	}
 }
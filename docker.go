@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+const dockerBin = "/usr/local/bin/docker"
+
+// dockerEngine talks to a local Docker installation by shelling out to the docker CLI.
+type dockerEngine struct {
+	bin string
+}
+
+func (e dockerEngine) name() string    { return "docker" }
+func (e dockerEngine) icon() string    { return "🐳" }
+func (e dockerEngine) binPath() string { return e.bin }
+
+// containerLs returns all containers sorted by "project" (Docker Compose project, Kubernetes namespace,
+// Minikube profile name, Talos cluster) and name.
+func (e dockerEngine) containerLs(ctx context.Context) ([]container, error) {
+	cmd := exec.CommandContext(ctx, e.bin, "container", "ls", "--all", "--no-trunc", "--format={{json .}}")
+	cmd.Stderr = os.Stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []container
+	d := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var c container
+		if err = d.Decode(&c); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		c.fill()
+		containers = append(containers, c)
+	}
+
+	sortContainers(containers)
+	return containers, nil
+}
+
+// networkLs returns all networks.
+func (e dockerEngine) networkLs(ctx context.Context) ([]network, error) {
+	cmd := exec.CommandContext(ctx, e.bin, "network", "ls", "--no-trunc", "--format={{json .}}")
+	cmd.Stderr = os.Stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var networks []network
+	d := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var n network
+		if err = d.Decode(&n); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		networks = append(networks, n)
+	}
+
+	sortNetworks(networks)
+	return networks, nil
+}
+
+// volumeLs returns all volumes.
+func (e dockerEngine) volumeLs(ctx context.Context) ([]volume, error) {
+	cmd := exec.CommandContext(ctx, e.bin, "volume", "ls", "--format={{json .}}")
+	cmd.Stderr = os.Stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []volume
+	d := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var v volume
+		if err = d.Decode(&v); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		volumes = append(volumes, v)
+	}
+
+	sortVolumes(volumes)
+	return volumes, nil
+}
+
+func (e dockerEngine) containerCmd(command string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := []string{command}
+	if command == "rm" {
+		args = append(args, "--force", "--volumes")
+	}
+	args = append(args, ids...)
+	cmd := exec.Command(e.bin, args...)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (e dockerEngine) networkRm(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	cmd := exec.Command(e.bin, append([]string{"network", "rm"}, names...)...)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (e dockerEngine) volumeRm(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	cmd := exec.Command(e.bin, append([]string{"volume", "rm", "--force"}, names...)...)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (e dockerEngine) pruneCmd() error {
+	var lastErr error
+	for _, cmdline := range []string{
+		"buildx prune --force",
+		"system prune --force --volumes",
+	} {
+		cmd := exec.Command(e.bin, strings.Split(cmdline, " ")...)
+		log.Print(strings.Join(cmd.Args, " "))
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			log.Print(err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// sortContainers sorts containers by project type, project name, and container name,
+// matching the grouping order rendered in the menu.
+func sortContainers(containers []container) {
+	sort.Slice(containers, func(i int, j int) bool {
+		if containers[i].project.typ != containers[j].project.typ {
+			return containers[i].project.typ < containers[j].project.typ
+		}
+		if containers[i].project.name != containers[j].project.name {
+			return containers[i].project.name < containers[j].project.name
+		}
+		return containers[i].Names < containers[j].Names
+	})
+}
+
+// sortNetworks sorts networks by driver, then name.
+func sortNetworks(networks []network) {
+	sort.Slice(networks, func(i int, j int) bool {
+		if networks[i].Driver != networks[j].Driver {
+			return networks[i].Driver < networks[j].Driver
+		}
+		return networks[i].Name < networks[j].Name
+	})
+}
+
+// sortVolumes sorts volumes by driver, then name.
+func sortVolumes(volumes []volume) {
+	sort.Slice(volumes, func(i int, j int) bool {
+		if volumes[i].Driver != volumes[j].Driver {
+			return volumes[i].Driver < volumes[j].Driver
+		}
+		return volumes[i].Name < volumes[j].Name
+	})
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	dockercontainer "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	dockervolume "github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+)
+
+// pingTimeout bounds how long newDockerEngine waits for the daemon socket before falling back
+// to the CLI.
+const pingTimeout = 2 * time.Second
+
+// dockerAPIEngine talks to the local Docker Engine API directly over its Unix socket via the
+// official SDK, instead of shelling out to the docker CLI for every refresh. It implements the
+// same engine interface as dockerEngine, plus containerStats for the container submenu.
+type dockerAPIEngine struct {
+	cli *client.Client
+}
+
+// newDockerEngine returns a dockerAPIEngine talking to the local Engine API if the daemon socket
+// is reachable, or falls back to dockerEngine (shelling out to the docker CLI) otherwise.
+func newDockerEngine() engine {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+		_, err = cli.Ping(ctx)
+		cancel()
+		if err == nil {
+			return dockerAPIEngine{cli: cli}
+		}
+	}
+
+	return dockerEngine{bin: dockerBin}
+}
+
+func (e dockerAPIEngine) name() string    { return "docker" }
+func (e dockerAPIEngine) icon() string    { return "🐳" }
+func (e dockerAPIEngine) binPath() string { return dockerBin }
+
+func apiContainer(dc types.Container) container {
+	var names []string
+	for _, n := range dc.Names {
+		names = append(names, strings.TrimPrefix(n, "/"))
+	}
+
+	c := container{
+		Command:   dc.Command,
+		CreatedAt: time.Unix(dc.Created, 0).Format("2006-01-02 15:04:05 -0700 MST"),
+		ID:        dc.ID,
+		Image:     dc.Image,
+		Labels:    joinLabels(dc.Labels),
+		Names:     strings.Join(names, ","),
+		State:     dc.State,
+		Status:    dc.Status,
+	}
+	c.fill()
+	return c
+}
+
+func (e dockerAPIEngine) containerLs(ctx context.Context) ([]container, error) {
+	dcs, err := e.cli.ContainerList(ctx, types.ContainerListOptions{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]container, 0, len(dcs))
+	for _, dc := range dcs {
+		containers = append(containers, apiContainer(dc))
+	}
+
+	sortContainers(containers)
+	return containers, nil
+}
+
+func (e dockerAPIEngine) networkLs(ctx context.Context) ([]network, error) {
+	dns, err := e.cli.NetworkList(ctx, types.NetworkListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make([]network, 0, len(dns))
+	for _, dn := range dns {
+		networks = append(networks, network{
+			CreatedAt: dn.Created.Format("2006-01-02 15:04:05 -0700 MST"),
+			Driver:    dn.Driver,
+			ID:        dn.ID,
+			Labels:    joinLabels(dn.Labels),
+			Name:      dn.Name,
+			Scope:     dn.Scope,
+		})
+	}
+
+	sortNetworks(networks)
+	return networks, nil
+}
+
+func (e dockerAPIEngine) volumeLs(ctx context.Context) ([]volume, error) {
+	resp, err := e.cli.VolumeList(ctx, dockervolume.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]volume, 0, len(resp.Volumes))
+	for _, dv := range resp.Volumes {
+		volumes = append(volumes, volume{Driver: dv.Driver, Labels: joinLabels(dv.Labels), Name: dv.Name})
+	}
+
+	sortVolumes(volumes)
+	return volumes, nil
+}
+
+func (e dockerAPIEngine) containerCmd(command string, ids []string) error {
+	ctx := context.Background()
+	for _, id := range ids {
+		var err error
+		switch command {
+		case "start":
+			err = e.cli.ContainerStart(ctx, id, types.ContainerStartOptions{})
+		case "stop":
+			err = e.cli.ContainerStop(ctx, id, dockercontainer.StopOptions{})
+		case "restart":
+			err = e.cli.ContainerRestart(ctx, id, dockercontainer.StopOptions{})
+		case "kill":
+			err = e.cli.ContainerKill(ctx, id, "SIGKILL")
+		case "pause":
+			err = e.cli.ContainerPause(ctx, id)
+		case "unpause":
+			err = e.cli.ContainerUnpause(ctx, id)
+		case "rm":
+			err = e.cli.ContainerRemove(ctx, id, types.ContainerRemoveOptions{Force: true, RemoveVolumes: true})
+		default:
+			err = fmt.Errorf("unexpected command %s", command)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e dockerAPIEngine) networkRm(names []string) error {
+	ctx := context.Background()
+	for _, name := range names {
+		if err := e.cli.NetworkRemove(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e dockerAPIEngine) volumeRm(names []string) error {
+	ctx := context.Background()
+	for _, name := range names {
+		if err := e.cli.VolumeRemove(ctx, name, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e dockerAPIEngine) pruneCmd() error {
+	ctx := context.Background()
+
+	if _, err := e.cli.BuildCachePrune(ctx, types.BuildCachePruneOptions{All: true}); err != nil {
+		return err
+	}
+	if _, err := e.cli.ContainersPrune(ctx, filters.Args{}); err != nil {
+		return err
+	}
+	if _, err := e.cli.ImagesPrune(ctx, filters.Args{}); err != nil {
+		return err
+	}
+	if _, err := e.cli.NetworksPrune(ctx, filters.Args{}); err != nil {
+		return err
+	}
+	if _, err := e.cli.VolumesPrune(ctx, filters.Args{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// containerStats returns a one-line CPU/memory summary for a container, shown as a line in
+// its submenu. It satisfies statsProvider; dockerEngine and podmanEngine don't, since the CLI
+// doesn't expose a cheap one-shot equivalent of the stats stream.
+func (e dockerAPIEngine) containerStats(ctx context.Context, id string) (string, error) {
+	resp, err := e.cli.ContainerStats(ctx, id, false)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var s types.StatsJSON
+	if err = json.NewDecoder(resp.Body).Decode(&s); err != nil {
+		return "", err
+	}
+
+	cpuDelta := float64(s.CPUStats.CPUUsage.TotalUsage) - float64(s.PreCPUStats.CPUUsage.TotalUsage)
+	sysDelta := float64(s.CPUStats.SystemUsage) - float64(s.PreCPUStats.SystemUsage)
+	var cpuPercent float64
+	if cpuDelta > 0 && sysDelta > 0 {
+		cpuPercent = (cpuDelta / sysDelta) * float64(len(s.CPUStats.CPUUsage.PercpuUsage)) * 100
+	}
+
+	memMB := float64(s.MemoryStats.Usage) / 1024 / 1024
+	return fmt.Sprintf("CPU %.1f%%, Mem %.0f MB", cpuPercent, memMB), nil
+}
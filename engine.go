@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// engine abstracts over a container runtime (Docker or Podman) so the rest of the
+// plugin does not need to care which one a given container, network, or volume
+// came from.
+type engine interface {
+	// name identifies the engine ("docker" or "podman"), both for the -engine flag
+	// and for log messages.
+	name() string
+
+	// icon is the emoji prefixed to that engine's section in the menu.
+	icon() string
+
+	// binPath is the path to the engine's CLI binary, used for auto-detection.
+	binPath() string
+
+	containerLs(ctx context.Context) ([]container, error)
+	networkLs(ctx context.Context) ([]network, error)
+	volumeLs(ctx context.Context) ([]volume, error)
+	containerCmd(command string, ids []string) error
+	networkRm(names []string) error
+	volumeRm(names []string) error
+	pruneCmd() error
+}
+
+// statsProvider is an optional capability of an engine: a cheap one-shot CPU/memory summary for
+// a single container, rendered as a line in that container's submenu. Only dockerAPIEngine
+// implements it today.
+type statsProvider interface {
+	containerStats(ctx context.Context, id string) (string, error)
+}
+
+// engineF, when set, restricts defaultCmd and containerCmd to a single named engine
+// instead of auto-detecting and showing all of them.
+var engineF = flag.String("engine", "", `use only the named engine ("docker" or "podman") instead of auto-detecting`)
+
+// engines returns the engines to use: either the one forced by -engine, or all engines
+// whose binary is available on disk, in display order (Docker before Podman).
+func engines() []engine {
+	all := []engine{
+		newDockerEngine(),
+		podmanEngine{bin: podmanBin},
+	}
+
+	if *engineF != "" {
+		for _, e := range all {
+			if e.name() == *engineF {
+				return []engine{e}
+			}
+		}
+		fmt.Fprintf(os.Stderr, "Unknown -engine %q.\n", *engineF)
+		os.Exit(2)
+	}
+
+	var res []engine
+	for _, e := range all {
+		if _, err := exec.LookPath(e.binPath()); err != nil {
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// selectedEngine returns the single engine that container-level subcommands (start, stop, ...)
+// should act on: the one forced by -engine, or the first auto-detected one.
+func selectedEngine() engine {
+	engs := engines()
+	if len(engs) == 0 {
+		fmt.Fprintln(os.Stderr, "No container engine found.")
+		os.Exit(1)
+	}
+	return engs[0]
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const podmanBin = "/usr/local/bin/podman"
+
+// podmanEngine talks to a local Podman installation by shelling out to the podman CLI.
+//
+// Unlike `docker ... --format={{json .}}`, which prints one JSON object per line, Podman's
+// `--format=json` prints a single JSON array, and field names and a few state strings differ
+// from Docker's. podmanContainer, podmanNetwork, and podmanVolume below decode that shape and
+// convert it to the types shared with dockerEngine.
+type podmanEngine struct {
+	bin string
+}
+
+func (e podmanEngine) name() string    { return "podman" }
+func (e podmanEngine) icon() string    { return "🦭" }
+func (e podmanEngine) binPath() string { return e.bin }
+
+// podmanContainer is the shape of a single element of `podman ps --all --format=json`.
+type podmanContainer struct {
+	ID        string            `json:"Id"`
+	Image     string            `json:"Image"`
+	Command   []string          `json:"Command"`
+	CreatedAt int64             `json:"Created"`
+	Names     []string          `json:"Names"`
+	State     string            `json:"State"`
+	Status    string            `json:"Status"`
+	Labels    map[string]string `json:"Labels"`
+}
+
+// container converts a podmanContainer into the engine-agnostic container type.
+func (pc podmanContainer) container() container {
+	var labels []string
+	for k, v := range pc.Labels {
+		labels = append(labels, k+"="+v)
+	}
+
+	// Podman reports "exited"/"running"/"paused" like Docker's State, but never
+	// populates Status with the "Up ..." prefix that running() also checks for.
+	c := container{
+		Command:   strings.Join(pc.Command, " "),
+		CreatedAt: time.Unix(pc.CreatedAt, 0).Format("2006-01-02 15:04:05 -0700 MST"),
+		ID:        pc.ID,
+		Image:     pc.Image,
+		Labels:    strings.Join(labels, ","),
+		Names:     strings.Join(pc.Names, ","),
+		State:     pc.State,
+		Status:    pc.Status,
+	}
+	c.fill()
+	return c
+}
+
+func (e podmanEngine) containerLs(ctx context.Context) ([]container, error) {
+	cmd := exec.CommandContext(ctx, e.bin, "ps", "--all", "--format=json")
+	cmd.Stderr = os.Stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []podmanContainer
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	containers := make([]container, 0, len(raw))
+	for _, pc := range raw {
+		containers = append(containers, pc.container())
+	}
+
+	sortContainers(containers)
+	return containers, nil
+}
+
+// podmanNetwork is the shape of a single element of `podman network ls --format=json`.
+type podmanNetwork struct {
+	ID     string            `json:"Id"`
+	Name   string            `json:"Name"`
+	Driver string            `json:"Driver"`
+	Labels map[string]string `json:"Labels"`
+}
+
+func (e podmanEngine) networkLs(ctx context.Context) ([]network, error) {
+	cmd := exec.CommandContext(ctx, e.bin, "network", "ls", "--format=json")
+	cmd.Stderr = os.Stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []podmanNetwork
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	networks := make([]network, 0, len(raw))
+	for _, pn := range raw {
+		var labels []string
+		for k, v := range pn.Labels {
+			labels = append(labels, k+"="+v)
+		}
+
+		networks = append(networks, network{
+			ID:     pn.ID,
+			Name:   pn.Name,
+			Driver: pn.Driver,
+			Labels: strings.Join(labels, ","),
+		})
+	}
+
+	sortNetworks(networks)
+	return networks, nil
+}
+
+// podmanVolume is the shape of a single element of `podman volume ls --format=json`.
+type podmanVolume struct {
+	Name   string            `json:"Name"`
+	Driver string            `json:"Driver"`
+	Labels map[string]string `json:"Labels"`
+}
+
+func (e podmanEngine) volumeLs(ctx context.Context) ([]volume, error) {
+	cmd := exec.CommandContext(ctx, e.bin, "volume", "ls", "--format=json")
+	cmd.Stderr = os.Stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []podmanVolume
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	volumes := make([]volume, 0, len(raw))
+	for _, pv := range raw {
+		var labels []string
+		for k, v := range pv.Labels {
+			labels = append(labels, k+"="+v)
+		}
+
+		volumes = append(volumes, volume{
+			Name:   pv.Name,
+			Driver: pv.Driver,
+			Labels: strings.Join(labels, ","),
+		})
+	}
+
+	sortVolumes(volumes)
+	return volumes, nil
+}
+
+func (e podmanEngine) containerCmd(command string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := []string{command}
+	if command == "rm" {
+		args = append(args, "--force", "--volumes")
+	}
+	args = append(args, ids...)
+	cmd := exec.Command(e.bin, args...)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (e podmanEngine) networkRm(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	cmd := exec.Command(e.bin, append([]string{"network", "rm"}, names...)...)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (e podmanEngine) volumeRm(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	cmd := exec.Command(e.bin, append([]string{"volume", "rm", "--force"}, names...)...)
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (e podmanEngine) pruneCmd() error {
+	cmd := exec.Command(e.bin, "system", "prune", "--force", "--volumes")
+	log.Print(strings.Join(cmd.Args, " "))
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Print(err)
+		return err
+	}
+	return nil
+}
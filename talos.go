@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"os/exec"
+	"time"
+)
+
+const (
+	talosctlBin = "/usr/local/bin/talosctl"
+
+	// talosHealthTimeout bounds how long talosStatus waits for "talosctl health" to converge,
+	// so an unreachable or unhealthy cluster can't block a whole menu refresh.
+	talosHealthTimeout = 5 * time.Second
+)
+
+// talosStatus returns a menu line and whether the named Talos cluster looks healthy, by asking
+// talosctl for that context's health. Unlike minikubeStatus, talosctl has no single-value JSON
+// health field to parse, so we just check whether the health check passed.
+func talosStatus(ctx context.Context, cluster string) (res []string, healthy bool) {
+	ctx, cancel := context.WithTimeout(ctx, talosHealthTimeout)
+	defer cancel()
+
+	err := exec.CommandContext(ctx, talosctlBin, "--context", cluster, "health", "--server=false").Run()
+	healthy = err == nil
+
+	status := "unhealthy"
+	if healthy {
+		status = "healthy"
+	}
+	res = append(res, "🔺 "+cluster+" "+status)
+
+	return
+}
+
+func talosBootstrap(cluster string) {
+	_ = exec.Command(talosctlBin, "--context", cluster, "bootstrap").Run()
+}
+
+func talosReset(cluster string) {
+	_ = exec.Command(talosctlBin, "--context", cluster, "reset").Run()
+}